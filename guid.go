@@ -1,6 +1,7 @@
-// This package provides immutable GUID structs and the functions
-// NewGUID, NewUUID, and Parse() for generating version 4 GUID and UUIDs,
-// as specified in RFC 4122.
+// This package provides immutable GUID structs and functions for
+// generating version 1, 3, 4, and 5 GUIDs/UUIDs and parsing them back
+// out of their various string and binary representations, as specified
+// in RFC 4122.
 // "GUID" is the term is used for a randomly generated value.
 // "UUID" is the term used for an globally unique (unchanging) value that is generated using the mac address.
 //
@@ -8,108 +9,144 @@
 package guid
 
 import (
-	"bytes"
-	"encoding/binary"
+	"crypto/rand"
 	"encoding/hex"
-	"errors"
-	"fmt"
-	"math/rand"
+	"io"
 	"net"
-	"regexp"
-	"time"
+	"sync"
 )
 
 type GUID [16]byte
 
-//borrowing the Parse() and ParseHex() from gouuid by Krzysztof Kowalik <chris@nu7hat.ch>
+// Use the net library to return all Interfaces
+// and capture any errors.
+func getInterfaces() []net.Interface {
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		panic("Unable to get interfaces.")
+	}
+	return interfaces
+}
 
-// Pattern used to parse hex string representation of the GUID.
-// FIXME: do something to consider both brackets at one time,
-// current one allows to parse string with only one opening
-// or closing bracket.
-const hexPattern = "^(urn\\:uuid\\:)?\\{?([a-z0-9]{8})-([a-z0-9]{4})-" +
-	"([1-5][a-z0-9]{3})-([a-z0-9]{4})-([a-z0-9]{12})\\}?$"
+// rander is the entropy source used to fill version 4 GUIDs. It defaults
+// to crypto/rand.Reader but can be swapped out with SetRand, e.g. in tests
+// that need deterministic output.
+var rander io.Reader = rand.Reader
 
-var re = regexp.MustCompile(hexPattern)
+// randPoolSize is how many GUIDs worth of bytes are read from rander at
+// once when the pooled, batching mode is enabled with EnableRandPool.
+const randPoolSize = 16 * 16
 
-// ParseHex creates a GUID object from given hex string
-// representation. Function accepts GUID string in following
-// formats:
-//
-//     uuid.ParseHex("6ba7b814-9dad-11d1-80b4-00c04fd430c8")
-//     uuid.ParseHex("{6ba7b814-9dad-11d1-80b4-00c04fd430c8}")
-//     uuid.ParseHex("urn:uuid:6ba7b814-9dad-11d1-80b4-00c04fd430c8")
-//
-func ParseHex(s string) (g *GUID, err error) {
-	md := re.FindStringSubmatch(s)
-	if md == nil {
-		err = errors.New("Invalid GUID string")
-		return
-	}
-	hash := md[2] + md[3] + md[4] + md[5] + md[6]
-	b, err := hex.DecodeString(hash)
-	if err != nil {
+var (
+	poolMu      sync.Mutex
+	poolEnabled = false
+	poolPos     = randPoolSize // forces a refill on the first call
+	pool        [randPoolSize]byte
+)
+
+// SetRand sets the entropy source used to generate GUIDs. A nil reader
+// restores the default of crypto/rand.Reader. This is most useful in
+// tests, paired with NewRandomFromReader for fully deterministic output.
+func SetRand(r io.Reader) {
+	if r == nil {
+		rander = rand.Reader
 		return
 	}
-	g = new(GUID)
-	copy(g[:], b)
-	return
+	rander = r
 }
 
-// Parse creates a GUID object from given bytes slice.
-func Parse(b []byte) (g *GUID, err error) {
-	if len(b) != 16 {
-		err = errors.New("Given slice is not valid GUID sequence")
-		return
-	}
-	g = new(GUID)
-	copy(g[:], b)
-	return
+// EnableRandPool enables a pool of random bits to be used as a small cache
+// to provide random data. This improves the throughput of generating
+// random GUIDs at the cost of reading larger chunks of random data at once
+// from rander and handing them out under a mutex.
+func EnableRandPool() {
+	poolMu.Lock()
+	defer poolMu.Unlock()
+	poolEnabled = true
 }
 
-// Use the net library to return all Interfaces
-// and capture any errors.
-func getInterfaces() []net.Interface {
-	interfaces, err := net.Interfaces()
+// DisableRandPool disables the random pool enabled by EnableRandPool and
+// discards any unused, buffered entropy.
+func DisableRandPool() {
+	poolMu.Lock()
+	defer poolMu.Unlock()
+	poolEnabled = false
+	poolPos = randPoolSize
+}
+
+//this will return a pseudo random GUID, falling back to a zero-value
+//GUID if the entropy source fails; use NewRandom if you need to know
+//whether generation succeeded
+func NewGUID() (guid *GUID) {
+	guid, err := NewRandom()
 	if err != nil {
-		panic("Unable to get interfaces.")
+		return new(GUID)
 	}
-	return interfaces
+	return guid
 }
 
-//this will return a pseudo random GUID
-func NewGUID() (guid *GUID) {
-	rand.Seed(time.Now().UTC().UnixNano())
-	return generateGUID()
+// NewRandom returns a version 4, random GUID using the package's entropy
+// source (crypto/rand.Reader by default, see SetRand and EnableRandPool).
+// It returns an error if reading from that source fails.
+func NewRandom() (guid *GUID, err error) {
+	if !poolEnabled {
+		return NewRandomFromReader(rander)
+	}
+	return newRandomFromPool()
 }
 
-//this will always return the UUID based off the machine's mac address
-func NewUUID() (guid *GUID) {
-	interfaces := getInterfaces()
-	mainInter := interfaces[0]
-	for _, inter := range interfaces {
-		if len(inter.HardwareAddr) > 0 {
-			mainInter = inter
-			break
-		}
+// NewRandomFromReader returns a version 4 GUID read entirely from r,
+// bypassing the package's pooled entropy source. This is mainly useful
+// for deterministic tests.
+func NewRandomFromReader(r io.Reader) (guid *GUID, err error) {
+	guid = new(GUID)
+	if _, err = io.ReadFull(r, guid[:]); err != nil {
+		return nil, err
 	}
-	buf := bytes.NewBuffer(mainInter.HardwareAddr)
-	seed, _ := binary.ReadVarint(buf)
-	rand.Seed(seed)
-	return generateGUID()
+	guid[6] = (guid[6] & 0xF) | (4 << 4)
+	guid[8] = (guid[8] & 0x3F) | 0x80
+	return guid, nil
 }
 
-func generateGUID() (guid *GUID) {
+func newRandomFromPool() (guid *GUID, err error) {
 	guid = new(GUID)
-	for i := 0; i < 16; i++ {
-		guid[i] = byte(rand.Intn(16))
+	poolMu.Lock()
+	defer poolMu.Unlock()
+	if poolPos == randPoolSize {
+		if _, err = io.ReadFull(rander, pool[:]); err != nil {
+			return nil, err
+		}
+		poolPos = 0
 	}
+	copy(guid[:], pool[poolPos:poolPos+16])
+	poolPos += 16
 	guid[6] = (guid[6] & 0xF) | (4 << 4)
-	guid[8] = (guid[8] | 0x40) & 0x7F
+	guid[8] = (guid[8] & 0x3F) | 0x80
+	return guid, nil
+}
+
+//this will return a new time-based (version 1) UUID whose node is the
+//machine's mac address unless overridden with SetNodeID;
+//it is a panic-free wrapper around NewV1, see that function for details
+func NewUUID() (guid *GUID) {
+	guid, err := NewV1()
+	if err != nil {
+		return new(GUID)
+	}
 	return guid
 }
 
 // Returns a string version of a GUID
 func (guid *GUID) String() string {
-	return fmt.Sprintf("%x-%x-%x-%x-%x", guid[0:4], guid[4:6], guid[6:8], guid[8:10], guid[10:])
+	var buf [36]byte
+	hex.Encode(buf[0:8], guid[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], guid[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], guid[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], guid[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:36], guid[10:16])
+	return string(buf[:])
 }
@@ -0,0 +1,29 @@
+package guid
+
+import "testing"
+
+func TestNewRandomVariant(t *testing.T) {
+	g, err := NewRandom()
+	if err != nil {
+		t.Fatalf("NewRandom() returned error: %v", err)
+	}
+	if v := g.Version(); v != 4 {
+		t.Errorf("Version() = %d, want 4", v)
+	}
+	if v := g.Variant(); v != VariantRFC4122 {
+		t.Errorf("Variant() = %v, want VariantRFC4122", v)
+	}
+}
+
+func TestNewRandomFromPoolVariant(t *testing.T) {
+	EnableRandPool()
+	defer DisableRandPool()
+
+	g, err := NewRandom()
+	if err != nil {
+		t.Fatalf("NewRandom() returned error: %v", err)
+	}
+	if v := g.Variant(); v != VariantRFC4122 {
+		t.Errorf("Variant() = %v, want VariantRFC4122", v)
+	}
+}
@@ -0,0 +1,43 @@
+package guid
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"hash"
+)
+
+// Well-known namespace GUIDs for NewV3 and NewV5, as defined in RFC 4122
+// Appendix C.
+var (
+	NamespaceDNS  = MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	NamespaceURL  = MustParse("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	NamespaceOID  = MustParse("6ba7b812-9dad-11d1-80b4-00c04fd430c8")
+	NamespaceX500 = MustParse("6ba7b814-9dad-11d1-80b4-00c04fd430c8")
+)
+
+// NewV3 returns a version 3, name-based GUID as specified by RFC 4122
+// §4.3: ns and name are hashed together with MD5 to produce a
+// deterministic identifier, so the same namespace and name always yield
+// the same GUID.
+func NewV3(ns *GUID, name []byte) *GUID {
+	return newFromHash(md5.New(), 3, ns, name)
+}
+
+// NewV5 returns a version 5, name-based GUID as specified by RFC 4122
+// §4.3. It behaves like NewV3 but hashes with SHA-1, which RFC 4122
+// recommends over MD5 for new applications.
+func NewV5(ns *GUID, name []byte) *GUID {
+	return newFromHash(sha1.New(), 5, ns, name)
+}
+
+func newFromHash(h hash.Hash, version byte, ns *GUID, name []byte) *GUID {
+	h.Write(ns[:])
+	h.Write(name)
+	sum := h.Sum(nil)
+
+	guid := new(GUID)
+	copy(guid[:], sum[:16])
+	guid[6] = (guid[6] & 0x0F) | (version << 4)
+	guid[8] = (guid[8] & 0x3F) | 0x80
+	return guid
+}
@@ -0,0 +1,88 @@
+package guid
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// MarshalText implements encoding.TextMarshaler.
+func (guid GUID) MarshalText() ([]byte, error) {
+	return []byte(guid.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts any of
+// the formats Parse does.
+func (guid *GUID) UnmarshalText(data []byte) error {
+	g, err := Parse(string(data))
+	if err != nil {
+		return err
+	}
+	*guid = *g
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The binary form is
+// the 16 raw GUID bytes.
+func (guid GUID) MarshalBinary() ([]byte, error) {
+	return guid[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It expects the
+// 16 raw GUID bytes, as produced by MarshalBinary.
+func (guid *GUID) UnmarshalBinary(data []byte) error {
+	g, err := FromBytes(data)
+	if err != nil {
+		return err
+	}
+	*guid = *g
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the GUID as its
+// canonical quoted string form.
+func (guid GUID) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + guid.String() + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null decodes to the
+// zero GUID.
+func (guid *GUID) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*guid = GUID{}
+		return nil
+	}
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return errors.New("guid: invalid JSON GUID")
+	}
+	return guid.UnmarshalText(data[1 : len(data)-1])
+}
+
+// Scan implements sql.Scanner, so a GUID can be read directly out of a
+// database/sql row. It accepts a string, a []byte in either 16-byte
+// binary or hex text form, or nil (which leaves the GUID as the zero
+// value).
+func (guid *GUID) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*guid = GUID{}
+		return nil
+	case string:
+		return guid.UnmarshalText([]byte(v))
+	case []byte:
+		if len(v) == 16 {
+			return guid.UnmarshalBinary(v)
+		}
+		return guid.UnmarshalText(v)
+	default:
+		return fmt.Errorf("guid: unsupported Scan type %T", src)
+	}
+}
+
+// Value implements driver.Valuer, so a GUID can be written directly to
+// a database/sql query as its canonical string form, which maps
+// straight onto e.g. a Postgres uuid column.
+func (guid GUID) Value() (driver.Value, error) {
+	return guid.String(), nil
+}
@@ -0,0 +1,129 @@
+package guid
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func testGUID() GUID {
+	return *MustParse("6ba7b814-9dad-11d1-80b4-00c04fd430c8")
+}
+
+func TestTextMarshalRoundTrip(t *testing.T) {
+	g := testGUID()
+	data, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() returned error: %v", err)
+	}
+	if string(data) != g.String() {
+		t.Errorf("MarshalText() = %q, want %q", data, g.String())
+	}
+
+	var g2 GUID
+	if err := g2.UnmarshalText(data); err != nil {
+		t.Fatalf("UnmarshalText() returned error: %v", err)
+	}
+	if g2 != g {
+		t.Errorf("UnmarshalText round trip = %v, want %v", g2, g)
+	}
+}
+
+func TestBinaryMarshalRoundTrip(t *testing.T) {
+	g := testGUID()
+	data, err := g.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() returned error: %v", err)
+	}
+	if len(data) != 16 {
+		t.Fatalf("MarshalBinary() returned %d bytes, want 16", len(data))
+	}
+
+	var g2 GUID
+	if err := g2.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() returned error: %v", err)
+	}
+	if g2 != g {
+		t.Errorf("UnmarshalBinary round trip = %v, want %v", g2, g)
+	}
+}
+
+func TestJSONMarshalRoundTrip(t *testing.T) {
+	g := testGUID()
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	if want := `"` + g.String() + `"`; string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var g2 GUID
+	if err := json.Unmarshal(data, &g2); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+	if g2 != g {
+		t.Errorf("json round trip = %v, want %v", g2, g)
+	}
+}
+
+func TestJSONUnmarshalNull(t *testing.T) {
+	g := testGUID()
+	if err := json.Unmarshal([]byte("null"), &g); err != nil {
+		t.Fatalf("json.Unmarshal(null) returned error: %v", err)
+	}
+	if g != (GUID{}) {
+		t.Errorf("json.Unmarshal(null) = %v, want zero GUID", g)
+	}
+}
+
+func TestJSONUnmarshalInvalid(t *testing.T) {
+	var g GUID
+	if err := json.Unmarshal([]byte("1234"), &g); err == nil {
+		t.Errorf("json.Unmarshal(1234) = nil error, want error")
+	}
+}
+
+func TestScan(t *testing.T) {
+	g := testGUID()
+	binary, _ := g.MarshalBinary()
+
+	tests := []struct {
+		name string
+		src  interface{}
+		want GUID
+	}{
+		{"string", g.String(), g},
+		{"binary []byte", binary, g},
+		{"hex text []byte", []byte(g.String()), g},
+		{"nil", nil, GUID{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got GUID
+			if err := got.Scan(tt.src); err != nil {
+				t.Fatalf("Scan(%v) returned error: %v", tt.src, err)
+			}
+			if got != tt.want {
+				t.Errorf("Scan(%v) = %v, want %v", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanUnsupportedType(t *testing.T) {
+	var g GUID
+	if err := g.Scan(42); err == nil {
+		t.Errorf("Scan(42) = nil error, want error")
+	}
+}
+
+func TestValue(t *testing.T) {
+	g := testGUID()
+	v, err := g.Value()
+	if err != nil {
+		t.Fatalf("Value() returned error: %v", err)
+	}
+	if v != g.String() {
+		t.Errorf("Value() = %v, want %v", v, g.String())
+	}
+}
@@ -0,0 +1,98 @@
+package guid
+
+import (
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+//borrowing the original idea of Parse()/ParseHex() from gouuid by Krzysztof Kowalik <chris@nu7hat.ch>
+
+// FromBytes creates a GUID object from a 16-byte slice. This was
+// formerly named Parse; Parse now covers the string representations
+// instead, since Go has no overloading there's no way to keep a
+// byte-slice Parse alongside it under the same name, so callers of the
+// old Parse([]byte) must switch to FromBytes.
+func FromBytes(b []byte) (g *GUID, err error) {
+	if len(b) != 16 {
+		err = errors.New("guid: given slice is not a valid GUID sequence")
+		return
+	}
+	g = new(GUID)
+	copy(g[:], b)
+	return
+}
+
+// ParseHex creates a GUID object from given hex string
+// representation. Function accepts GUID string in following
+// formats:
+//
+//	uuid.ParseHex("6ba7b814-9dad-11d1-80b4-00c04fd430c8")
+//	uuid.ParseHex("{6ba7b814-9dad-11d1-80b4-00c04fd430c8}")
+//	uuid.ParseHex("urn:uuid:6ba7b814-9dad-11d1-80b4-00c04fd430c8")
+func ParseHex(s string) (g *GUID, err error) {
+	return scanGUID(s, false)
+}
+
+// Parse creates a GUID from any string representation this package
+// produces: everything ParseHex accepts, plus a bare 32-character hex
+// string with no hyphens.
+func Parse(s string) (g *GUID, err error) {
+	return scanGUID(s, true)
+}
+
+// MustParse is like Parse but panics if s cannot be parsed. It is meant
+// for package-level variable initialization, where a malformed literal
+// is a programming error.
+func MustParse(s string) *GUID {
+	g, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// scanGUID is a hand-written replacement for the regexp this package
+// used to validate GUID strings with: on the hot path it runs roughly
+// an order of magnitude faster than regexp.FindStringSubmatch, and
+// unlike the old "[a-z0-9]" character class it accepts uppercase hex
+// digits too.
+func scanGUID(s string, allowPlainHex bool) (*GUID, error) {
+	if len(s) >= 9 && strings.EqualFold(s[:9], "urn:uuid:") {
+		s = s[9:]
+	}
+	if len(s) >= 2 && s[0] == '{' && s[len(s)-1] == '}' {
+		s = s[1 : len(s)-1]
+	}
+
+	g := new(GUID)
+	switch len(s) {
+	case 32:
+		if !allowPlainHex {
+			return nil, invalidGUIDError(s)
+		}
+		if _, err := hex.Decode(g[:], []byte(s)); err != nil {
+			return nil, invalidGUIDError(s)
+		}
+	case 36:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return nil, invalidGUIDError(s)
+		}
+		pos := 0
+		for _, seg := range [5][2]int{{0, 8}, {9, 13}, {14, 18}, {19, 23}, {24, 36}} {
+			n, err := hex.Decode(g[pos:], []byte(s[seg[0]:seg[1]]))
+			if err != nil {
+				return nil, invalidGUIDError(s)
+			}
+			pos += n
+		}
+	default:
+		return nil, invalidGUIDError(s)
+	}
+	return g, nil
+}
+
+func invalidGUIDError(s string) error {
+	return errors.New("guid: invalid GUID string " + strconv.Quote(s))
+}
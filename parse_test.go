@@ -0,0 +1,55 @@
+package guid
+
+import "testing"
+
+func TestParseForms(t *testing.T) {
+	const canonical = "6ba7b814-9dad-11d1-80b4-00c04fd430c8"
+	tests := []string{
+		canonical,
+		"6BA7B814-9DAD-11D1-80B4-00C04FD430C8",
+		"{6ba7b814-9dad-11d1-80b4-00c04fd430c8}",
+		"urn:uuid:6ba7b814-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b8149dad11d180b400c04fd430c8",
+	}
+	for _, s := range tests {
+		g, err := Parse(s)
+		if err != nil {
+			t.Errorf("Parse(%q) returned error: %v", s, err)
+			continue
+		}
+		if g.String() != canonical {
+			t.Errorf("Parse(%q).String() = %q, want %q", s, g.String(), canonical)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, s := range []string{"", "not-a-guid", "6ba7b814-9dad-11d1-80b4-00c04fd430c"} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q) = nil error, want error", s)
+		}
+	}
+}
+
+func TestParseHexRejectsPlainHex(t *testing.T) {
+	if _, err := ParseHex("6ba7b8149dad11d180b400c04fd430c8"); err == nil {
+		t.Errorf("ParseHex(plain 32-hex) = nil error, want error")
+	}
+}
+
+func TestFromBytesRoundTrip(t *testing.T) {
+	g, err := NewRandom()
+	if err != nil {
+		t.Fatalf("NewRandom() returned error: %v", err)
+	}
+	g2, err := FromBytes(g[:])
+	if err != nil {
+		t.Fatalf("FromBytes() returned error: %v", err)
+	}
+	if *g != *g2 {
+		t.Errorf("FromBytes(g[:]) = %v, want %v", g2, g)
+	}
+	if _, err := FromBytes(g[:15]); err == nil {
+		t.Errorf("FromBytes(short slice) = nil error, want error")
+	}
+}
@@ -0,0 +1,125 @@
+package guid
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// lillianEpoch is the number of 100-nanosecond intervals between the
+// start of the Gregorian calendar (1582-10-15) and the Unix epoch
+// (1970-01-01), the offset RFC 4122 §4.2 timestamps are measured from.
+const lillianEpoch = 0x01B21DD213814000
+
+var (
+	nodeMu sync.Mutex
+	nodeID [6]byte
+
+	clockMu       sync.Mutex
+	clockSeq      uint16
+	lastTimestamp uint64
+)
+
+func init() {
+	nodeMu.Lock()
+	nodeID = defaultNodeID()
+	nodeMu.Unlock()
+
+	var seed [2]byte
+	if _, err := io.ReadFull(rander, seed[:]); err == nil {
+		clockMu.Lock()
+		clockSeq = binary.BigEndian.Uint16(seed[:]) & 0x3FFF
+		clockMu.Unlock()
+	}
+}
+
+// defaultNodeID picks the node identifier NewV1 embeds in generated
+// GUIDs: the hardware address of the first network interface that has
+// one, or, failing that, a randomly generated identifier with the
+// multicast bit set as permitted by RFC 4122 §4.5. Unlike getInterfaces, this
+// must never panic: it runs from init, so a sandbox where
+// net.Interfaces() errors (no network namespace, GOOS=js, etc.) would
+// otherwise crash on package import rather than on first use.
+func defaultNodeID() [6]byte {
+	var id [6]byte
+	if interfaces, err := net.Interfaces(); err == nil {
+		for _, inter := range interfaces {
+			if len(inter.HardwareAddr) >= 6 {
+				copy(id[:], inter.HardwareAddr)
+				return id
+			}
+		}
+	}
+	io.ReadFull(rander, id[:])
+	id[0] |= 0x01
+	return id
+}
+
+// SetNodeID overrides the 48-bit node identifier NewV1 embeds in
+// generated GUIDs. Only the first 6 bytes of id are used; passing nil
+// reverts to the machine's MAC address (or a random node ID if none is
+// available).
+func SetNodeID(id []byte) {
+	nodeMu.Lock()
+	defer nodeMu.Unlock()
+	if id == nil {
+		nodeID = defaultNodeID()
+		return
+	}
+	var n [6]byte
+	copy(n[:], id)
+	nodeID = n
+}
+
+// NodeID returns the 48-bit node identifier embedded in a version 1
+// GUID.
+func NodeID(guid *GUID) []byte {
+	node := make([]byte, 6)
+	copy(node, guid[10:])
+	return node
+}
+
+// nextTimestamp returns the RFC 4122 §4.2 timestamp and clock sequence
+// to use for the next version 1 GUID. It guarantees the timestamp never
+// goes backwards or repeats within this process: if the wall clock
+// hasn't advanced since the previous call, the timestamp is ticked
+// forward by one 100ns unit, and if the wall clock actually regressed,
+// the clock sequence is bumped as required by the RFC.
+func nextTimestamp() (timestamp uint64, seq uint16) {
+	clockMu.Lock()
+	defer clockMu.Unlock()
+
+	now := uint64(time.Now().UnixNano()/100) + lillianEpoch
+	if now <= lastTimestamp {
+		if now < lastTimestamp {
+			clockSeq = (clockSeq + 1) & 0x3FFF
+		}
+		now = lastTimestamp + 1
+	}
+	lastTimestamp = now
+	return now, clockSeq
+}
+
+// NewV1 returns a version 1, time-based GUID as specified by RFC 4122
+// §4.2. The timestamp reflects the current time, the node is the
+// machine's MAC address (see SetNodeID to override it), and the clock
+// sequence is seeded randomly and persisted for the lifetime of the
+// process, incrementing whenever the system clock is observed to go
+// backwards.
+func NewV1() (guid *GUID, err error) {
+	timestamp, seq := nextTimestamp()
+
+	guid = new(GUID)
+	binary.BigEndian.PutUint32(guid[0:4], uint32(timestamp))
+	binary.BigEndian.PutUint16(guid[4:6], uint16(timestamp>>32))
+	binary.BigEndian.PutUint16(guid[6:8], uint16(timestamp>>48)&0x0FFF|(1<<12))
+	binary.BigEndian.PutUint16(guid[8:10], seq&0x3FFF|0x8000)
+
+	nodeMu.Lock()
+	copy(guid[10:], nodeID[:])
+	nodeMu.Unlock()
+
+	return guid, nil
+}
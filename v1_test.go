@@ -0,0 +1,89 @@
+package guid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNewV1(t *testing.T) {
+	g, err := NewV1()
+	if err != nil {
+		t.Fatalf("NewV1() returned error: %v", err)
+	}
+	if v := g.Version(); v != 1 {
+		t.Errorf("Version() = %d, want 1", v)
+	}
+	if v := g.Variant(); v != VariantRFC4122 {
+		t.Errorf("Variant() = %v, want VariantRFC4122", v)
+	}
+
+	nodeMu.Lock()
+	want := nodeID
+	nodeMu.Unlock()
+	if !bytes.Equal(NodeID(g), want[:]) {
+		t.Errorf("NodeID(g) = %x, want %x", NodeID(g), want)
+	}
+}
+
+func TestSetNodeIDRoundTrip(t *testing.T) {
+	nodeMu.Lock()
+	original := nodeID
+	nodeMu.Unlock()
+	defer func() {
+		nodeMu.Lock()
+		nodeID = original
+		nodeMu.Unlock()
+	}()
+
+	want := []byte{0xDE, 0xAD, 0xBE, 0xEF, 0x00, 0x01}
+	SetNodeID(want)
+
+	g, err := NewV1()
+	if err != nil {
+		t.Fatalf("NewV1() returned error: %v", err)
+	}
+	if got := NodeID(g); !bytes.Equal(got, want) {
+		t.Errorf("NodeID(g) = %x, want %x", got, want)
+	}
+
+	SetNodeID(nil)
+	g2, err := NewV1()
+	if err != nil {
+		t.Fatalf("NewV1() returned error: %v", err)
+	}
+	if got := NodeID(g2); bytes.Equal(got, want) {
+		t.Errorf("NodeID(g2) = %x, want default node ID restored after SetNodeID(nil)", got)
+	}
+}
+
+func TestNextTimestampMonotonic(t *testing.T) {
+	first, _ := nextTimestamp()
+	second, _ := nextTimestamp()
+	if second <= first {
+		t.Errorf("nextTimestamp() = %d then %d, want strictly increasing", first, second)
+	}
+}
+
+func TestNextTimestampClockSeqBumpsOnRegression(t *testing.T) {
+	clockMu.Lock()
+	originalSeq, originalLast := clockSeq, lastTimestamp
+	// Simulate the wall clock having regressed by pinning lastTimestamp
+	// far in the future relative to the next call's time.Now() reading.
+	pinnedLast := originalLast + uint64(1)<<40
+	lastTimestamp = pinnedLast
+	clockMu.Unlock()
+	defer func() {
+		clockMu.Lock()
+		clockSeq, lastTimestamp = originalSeq, originalLast
+		clockMu.Unlock()
+	}()
+
+	timestamp, seq := nextTimestamp()
+
+	if seq == originalSeq {
+		t.Errorf("clock sequence did not change after a simulated backwards clock")
+	}
+	if timestamp != pinnedLast+1 {
+		t.Errorf("nextTimestamp() = %d, want %d (lastTimestamp+1)", timestamp, pinnedLast+1)
+	}
+}
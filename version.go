@@ -0,0 +1,73 @@
+package guid
+
+import "encoding/hex"
+
+// Version returns the GUID's RFC 4122 version, the value encoded in the
+// top four bits of byte 6 (1-5 for the versions this package generates,
+// 0 for the zero-value GUID).
+func (guid *GUID) Version() int {
+	return int(guid[6] >> 4)
+}
+
+// Variant identifies which layout a GUID's variant-specific bits follow,
+// as defined by RFC 4122 §4.1.1.
+type Variant int
+
+const (
+	// VariantNCS is the reserved, NCS backward-compatibility variant.
+	VariantNCS Variant = iota
+	// VariantRFC4122 is the variant used by the GUIDs this package
+	// generates.
+	VariantRFC4122
+	// VariantMicrosoft is the reserved Microsoft backward-compatibility
+	// variant.
+	VariantMicrosoft
+	// VariantFuture is reserved for future definition.
+	VariantFuture
+)
+
+func (v Variant) String() string {
+	switch v {
+	case VariantRFC4122:
+		return "RFC4122"
+	case VariantMicrosoft:
+		return "Microsoft"
+	case VariantFuture:
+		return "Future"
+	default:
+		return "NCS"
+	}
+}
+
+// Variant returns the GUID's variant, decoded from the high bits of
+// byte 8 as described in RFC 4122 §4.1.1.
+func (guid *GUID) Variant() Variant {
+	switch {
+	case guid[8]&0x80 == 0x00:
+		return VariantNCS
+	case guid[8]&0xC0 == 0x80:
+		return VariantRFC4122
+	case guid[8]&0xE0 == 0xC0:
+		return VariantMicrosoft
+	default:
+		return VariantFuture
+	}
+}
+
+// URN returns the GUID formatted as a URN, e.g.
+// "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+func (guid *GUID) URN() string {
+	return "urn:uuid:" + guid.String()
+}
+
+// Braced returns the GUID's canonical string form wrapped in curly
+// braces, e.g. "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}".
+func (guid *GUID) Braced() string {
+	return "{" + guid.String() + "}"
+}
+
+// Hex returns the GUID as a 32-character string of hex digits with no
+// separators, e.g. "6ba7b8109dad11d180b400c04fd430c8".
+func (guid *GUID) Hex() string {
+	return hex.EncodeToString(guid[:])
+}
@@ -0,0 +1,43 @@
+package guid
+
+import "testing"
+
+func TestVersionVariantRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		guid    *GUID
+		version int
+	}{
+		{"v1", NewUUID(), 1},
+		{"v3", NewV3(NamespaceDNS, []byte("example.com")), 3},
+		{"v4", NewGUID(), 4},
+		{"v5", NewV5(NamespaceDNS, []byte("example.com")), 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if v := tt.guid.Version(); v != tt.version {
+				t.Errorf("Version() = %d, want %d", v, tt.version)
+			}
+			if v := tt.guid.Variant(); v != VariantRFC4122 {
+				t.Errorf("Variant() = %v, want VariantRFC4122", v)
+			}
+		})
+	}
+}
+
+func TestVariantString(t *testing.T) {
+	tests := []struct {
+		variant Variant
+		want    string
+	}{
+		{VariantNCS, "NCS"},
+		{VariantRFC4122, "RFC4122"},
+		{VariantMicrosoft, "Microsoft"},
+		{VariantFuture, "Future"},
+	}
+	for _, tt := range tests {
+		if got := tt.variant.String(); got != tt.want {
+			t.Errorf("Variant(%d).String() = %q, want %q", tt.variant, got, tt.want)
+		}
+	}
+}